@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the top-level shape of an `-config enumgen.yaml` file: a list of
+// OpenAPI sources to read enums from, and a list of targets to render them
+// to. A single command can therefore produce, say, TS enums for a frontend
+// and a Zod schema for runtime validation from one spec.
+type Config struct {
+	Sources []SourceConfig `yaml:"sources"`
+	Targets []TargetConfig `yaml:"targets"`
+}
+
+// SourceConfig is one OpenAPI document to extract enums from, optionally
+// filtered by schema name.
+type SourceConfig struct {
+	Path    string   `yaml:"path"`
+	Include []string `yaml:"include"`
+	Exclude []string `yaml:"exclude"`
+
+	// EmitUnions and EmitInlineEnums opt into the oneOf/anyOf union and
+	// inline-property-enum extraction pass. Both default to off so existing
+	// configs see no diff.
+	EmitUnions      bool `yaml:"emitUnions"`
+	EmitInlineEnums bool `yaml:"emitInlineEnums"`
+}
+
+// TargetConfig is one rendering of the combined, filtered schema set.
+type TargetConfig struct {
+	Format       string `yaml:"format"` // ts-enum, ts-const-object, zod-enum, io-ts, python-enum, kotlin-enum, custom
+	TemplatePath string `yaml:"templatePath"`
+	Output       string `yaml:"output"`
+	Quote        string `yaml:"quote"`
+	NoSort       bool   `yaml:"noSort"`
+}
+
+// LoadConfig reads and validates a config file from path.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	var cfg Config
+
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+
+	if len(cfg.Sources) == 0 {
+		return nil, fmt.Errorf("config %s: at least one source is required", path)
+	}
+
+	if len(cfg.Targets) == 0 {
+		return nil, fmt.Errorf("config %s: at least one target is required", path)
+	}
+
+	for i, t := range cfg.Targets {
+		switch t.Quote {
+		case "", "single", "double":
+		default:
+			return nil, fmt.Errorf("config %s: targets[%d].quote must be \"single\" or \"double\"", path, i)
+		}
+	}
+
+	return &cfg, nil
+}
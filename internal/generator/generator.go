@@ -0,0 +1,184 @@
+// Package generator turns a Config (one or more OpenAPI sources, one or
+// more render targets) into generated files. The CLI is a thin wrapper
+// around it: a plain `-input/-output` invocation is just a single-source,
+// single-target Config built in memory.
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/model"
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/openapi"
+	"github.com/taknb2nch/openapi-ts-enumgen/templates"
+)
+
+// Generator runs a Config to completion: loading every source, filtering
+// and merging their schemas, then rendering every target.
+type Generator struct {
+	cfg      *Config
+	registry *templates.Registry
+}
+
+// New builds a Generator for cfg.
+func New(cfg *Config) *Generator {
+	return &Generator{cfg: cfg, registry: templates.NewRegistry()}
+}
+
+// templateData is what every built-in template is executed against.
+type templateData struct {
+	SourceBase string
+	Schemas    []model.EnumSchema
+	Unions     []model.UnionType
+}
+
+// Run loads every source, merges their (filtered, de-duplicated) schemas,
+// and renders every target against that shared set.
+func (g *Generator) Run() error {
+	schemas, unions, sourceBase, err := g.load()
+	if err != nil {
+		return err
+	}
+
+	for i, target := range g.cfg.Targets {
+		if err := g.runTarget(target, schemas, unions, sourceBase); err != nil {
+			return fmt.Errorf("target[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) load() ([]model.EnumSchema, []model.UnionType, string, error) {
+	var (
+		schemas    []model.EnumSchema
+		unions     []model.UnionType
+		bases      []string
+		seenSchema = map[string]bool{}
+		seenUnion  = map[string]bool{}
+	)
+
+	for _, src := range g.cfg.Sources {
+		doc, err := openapi.Load(src.Path)
+		if err != nil {
+			return nil, nil, "", err
+		}
+
+		opts := openapi.Options{EmitUnions: src.EmitUnions, EmitInlineEnums: src.EmitInlineEnums}
+
+		all, allUnions, err := openapi.ExtractEnums(doc, opts)
+		if err != nil {
+			return nil, nil, "", err
+		}
+
+		bases = append(bases, filepath.Base(src.Path))
+
+		for _, s := range all {
+			if seenSchema[s.Name] || !matchesFilters(s.Name, src.Include, src.Exclude) {
+				continue
+			}
+
+			seenSchema[s.Name] = true
+
+			s.See = fmt.Sprintf("OpenAPI components/schemas/%s (%s)", s.Name, filepath.Base(src.Path))
+
+			schemas = append(schemas, s)
+		}
+
+		for _, u := range allUnions {
+			if seenUnion[u.Name] || !matchesFilters(u.Name, src.Include, src.Exclude) {
+				continue
+			}
+
+			seenUnion[u.Name] = true
+
+			unions = append(unions, u)
+		}
+	}
+
+	return schemas, unions, strings.Join(bases, ", "), nil
+}
+
+func matchesFilters(name string, include, exclude []string) bool {
+	for _, pattern := range exclude {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, pattern := range include {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *Generator) runTarget(target TargetConfig, schemas []model.EnumSchema, unions []model.UnionType, sourceBase string) error {
+	sorted := make([]model.EnumSchema, len(schemas))
+	copy(sorted, schemas)
+
+	if !target.NoSort {
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].Name < sorted[j].Name
+		})
+	}
+
+	src, err := g.templateSource(target)
+	if err != nil {
+		return err
+	}
+
+	quoteOpt := target.Quote
+	if quoteOpt == "" {
+		quoteOpt = "double"
+	}
+
+	tpl, err := template.New(target.Output).Funcs(templates.FuncMap(quoteOpt)).Parse(src)
+	if err != nil {
+		return fmt.Errorf("parse template: %w", err)
+	}
+
+	var out bytes.Buffer
+
+	if err := tpl.Execute(&out, templateData{SourceBase: sourceBase, Schemas: sorted, Unions: unions}); err != nil {
+		return fmt.Errorf("render template: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target.Output), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(target.Output, out.Bytes(), 0o644)
+}
+
+// templateSource resolves the text/template source for a target: a
+// user-supplied -template/templatePath file always wins, otherwise it's
+// looked up in the registry by format.
+func (g *Generator) templateSource(target TargetConfig) (string, error) {
+	if target.TemplatePath != "" {
+		return g.registry.Load(target.TemplatePath)
+	}
+
+	format := target.Format
+	if format == "" {
+		format = "ts-enum"
+	}
+
+	src, ok := g.registry.Lookup(format)
+	if !ok {
+		return "", fmt.Errorf("format %q has no built-in template; set templatePath", format)
+	}
+
+	return src, nil
+}
@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoad_MergesSourcesAndDedupesByNameFirstWins(t *testing.T) {
+	g := New(&Config{
+		Sources: []SourceConfig{
+			{Path: "testdata/a.yaml"},
+			{Path: "testdata/b.yaml"},
+		},
+	})
+
+	schemas, unions, sourceBase, err := g.load()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	if len(unions) != 0 {
+		t.Errorf("unions = %+v, want none", unions)
+	}
+
+	if sourceBase != "a.yaml, b.yaml" {
+		t.Errorf("sourceBase = %q, want %q", sourceBase, "a.yaml, b.yaml")
+	}
+
+	byName := map[string][]string{}
+	for _, s := range schemas {
+		var values []string
+		for _, item := range s.Items {
+			values = append(values, item.Value)
+		}
+
+		byName[s.Name] = values
+	}
+
+	if len(schemas) != 3 {
+		t.Fatalf("schemas = %+v, want 3 (Color, Size, Shape)", schemas)
+	}
+
+	wantColor := []string{"red", "green"}
+	gotColor := byName["Color"]
+
+	if len(gotColor) != len(wantColor) || gotColor[0] != wantColor[0] || gotColor[1] != wantColor[1] {
+		t.Errorf("Color values = %v, want %v (source A's Color must win over source B's)", gotColor, wantColor)
+	}
+
+	if _, ok := byName["Size"]; !ok {
+		t.Errorf("schemas = %+v, want Size from source A", schemas)
+	}
+
+	if _, ok := byName["Shape"]; !ok {
+		t.Errorf("schemas = %+v, want Shape from source B", schemas)
+	}
+}
+
+func TestLoad_IncludeFiltersToMatchingSchemas(t *testing.T) {
+	g := New(&Config{
+		Sources: []SourceConfig{
+			{Path: "testdata/a.yaml", Include: []string{"Size"}},
+		},
+	})
+
+	schemas, _, _, err := g.load()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	if len(schemas) != 1 || schemas[0].Name != "Size" {
+		t.Fatalf("schemas = %+v, want only Size", schemas)
+	}
+}
+
+func TestLoad_ExcludeDropsMatchingSchemas(t *testing.T) {
+	g := New(&Config{
+		Sources: []SourceConfig{
+			{Path: "testdata/a.yaml", Exclude: []string{"Color"}},
+		},
+	})
+
+	schemas, _, _, err := g.load()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	if len(schemas) != 1 || schemas[0].Name != "Size" {
+		t.Fatalf("schemas = %+v, want only Size (Color excluded)", schemas)
+	}
+}
+
+func TestRun_RendersMergedSchemasToOutputFile(t *testing.T) {
+	outPath := filepath.Join(t.TempDir(), "enums.ts")
+
+	g := New(&Config{
+		Sources: []SourceConfig{
+			{Path: "testdata/a.yaml"},
+			{Path: "testdata/b.yaml"},
+		},
+		Targets: []TargetConfig{
+			{Format: "ts-enum", Output: outPath},
+		},
+	})
+
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	for _, want := range []string{"export enum Color", "export enum Size", "export enum Shape"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("output = %s, want it to contain %q", out, want)
+		}
+	}
+}
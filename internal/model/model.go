@@ -0,0 +1,43 @@
+// Package model holds the schema-agnostic representation of an extracted
+// enum that every loader, generator and template shares.
+package model
+
+// Kind identifies the underlying OpenAPI type of an enum, so templates can
+// tell whether a member's Value needs quoting.
+type Kind string
+
+const (
+	KindString  Kind = "string"
+	KindInteger Kind = "integer"
+	KindNumber  Kind = "number"
+)
+
+// EnumSchema is a single enum extracted from a spec, along with the
+// metadata needed to render it.
+type EnumSchema struct {
+	Name        string
+	Description string
+	Deprecated  bool
+	Since       string
+	See         string
+	Kind        Kind
+	Items       []EnumItem
+}
+
+// EnumItem is a single member of an EnumSchema. Value holds the literal's
+// textual form; for Kind == KindString it still needs quoting, for the
+// numeric kinds it's already a valid unquoted TS literal.
+type EnumItem struct {
+	Value string
+	Key   string
+	Label string
+}
+
+// UnionType is a discriminated string-literal union recognized from a
+// oneOf/anyOf schema whose members are all single-value string enums, e.g.
+// `type Foo = "a" | "b" | "c";`.
+type UnionType struct {
+	Name        string
+	Description string
+	Literals    []string
+}
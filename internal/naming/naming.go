@@ -0,0 +1,86 @@
+// Package naming turns raw enum values into valid, de-duplicated TypeScript
+// identifiers.
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var identPartsRE = regexp.MustCompile(`[A-Za-z0-9]+`)
+
+// ToTSMemberKey derives a PascalCase TypeScript member name from a raw enum
+// value, e.g. "in-progress" -> "InProgress".
+func ToTSMemberKey(value string) string {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return "Value"
+	}
+
+	parts := identPartsRE.FindAllString(value, -1)
+
+	if len(parts) == 0 {
+		return "Value"
+	}
+
+	for i, p := range parts {
+		parts[i] = upperFirst(strings.ToLower(p))
+	}
+
+	key := strings.Join(parts, "")
+
+	// starts with digit
+	if key != "" && key[0] >= '0' && key[0] <= '9' {
+		key = "_" + key
+	}
+
+	if isReservedTSIdent(key) {
+		key += "_"
+	}
+
+	return key
+}
+
+// Dedupe appends a numeric suffix to key if it has already been seen,
+// tracking the count in usedKeys.
+func Dedupe(usedKeys map[string]int, key string) string {
+	if c, ok := usedKeys[key]; ok {
+		c++
+
+		usedKeys[key] = c
+
+		return fmt.Sprintf("%s_%d", key, c)
+	}
+
+	usedKeys[key] = 0
+
+	return key
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r := []rune(s)
+	r[0] = []rune(strings.ToUpper(string(r[0])))[0]
+
+	return string(r)
+}
+
+// minimal reserved list (enough to avoid obvious breakage)
+func isReservedTSIdent(s string) bool {
+	switch s {
+	case "Default", "Class", "Function", "Var", "Let", "Const", "Enum",
+		"Export", "Import", "Type", "Interface", "Extends", "Implements",
+		"Public", "Private", "Protected", "New", "Delete", "Return",
+		"Switch", "Case", "For", "While", "If", "Else", "Try", "Catch",
+		"Finally", "Throw", "In", "Of", "This", "Super",
+		"Null", "True", "False", "Void", "Any", "Never", "Unknown":
+		return true
+	default:
+		return false
+	}
+}
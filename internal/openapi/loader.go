@@ -0,0 +1,477 @@
+// Package openapi loads an OpenAPI 3.0/3.1 document (resolving internal and
+// external $ref) and extracts every string, integer or number enum schema
+// (including OpenAPI 3.1 `const`) it can find, regardless of whether it sits in components.schemas or inline in a path,
+// parameter, request body or response.
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/model"
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/naming"
+)
+
+// Load reads and fully resolves (including external file $ref) the OpenAPI
+// document at path.
+func Load(path string) (*openapi3.T, error) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	doc, err := loader.LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("validate %s: %w", path, err)
+	}
+
+	return doc, nil
+}
+
+// Options controls the optional second extraction pass that recognizes
+// patterns beyond a plain named or inline enum schema.
+type Options struct {
+	// EmitUnions recognizes oneOf/anyOf schemas whose members are all
+	// single-value string enums as a TS string-literal union, plus a
+	// "<Name>Kind" enum when a discriminator is present.
+	EmitUnions bool
+
+	// EmitInlineEnums recurses into named components.schemas object
+	// properties, synthesizing a "<Parent><Property>" enum for each inline
+	// enum property found.
+	EmitInlineEnums bool
+}
+
+// extractor accumulates enum schemas while de-duplicating structurally
+// identical ones (same values in the same order) so the same enum reached
+// through two different $ref paths is only emitted once.
+type extractor struct {
+	opts        Options
+	bySignature map[string]string // structural signature -> schema name already emitted
+	result      []model.EnumSchema
+	unions      []model.UnionType
+	err         error
+}
+
+// ExtractEnums walks the fully resolved document and returns every
+// string, integer or number enum schema it finds: named components.schemas
+// entries, and anonymous inline schemas under path/operation parameters,
+// request bodies and responses. Anonymous enums are given a synthesized,
+// stable name of the form OperationId_ParamName. With opts.EmitUnions it
+// also returns discriminated string-literal unions; with
+// opts.EmitInlineEnums it also returns a "<Parent><Property>" enum for each
+// inline enum property of a named schema.
+//
+// It returns an error if a schema's x-enum-varnames, x-enum-descriptions or
+// x-enum-values vendor extension doesn't line up with its enum values.
+func ExtractEnums(doc *openapi3.T, opts Options) ([]model.EnumSchema, []model.UnionType, error) {
+	ex := &extractor{opts: opts, bySignature: map[string]string{}}
+
+	if doc.Components != nil {
+		names := make([]string, 0, len(doc.Components.Schemas))
+
+		for name := range doc.Components.Schemas {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			ref := doc.Components.Schemas[name]
+
+			ex.addNamed(name, ref)
+
+			if opts.EmitUnions {
+				ex.addUnion(name, ref)
+			}
+
+			if opts.EmitInlineEnums {
+				ex.walkNamedProperties(name, ref)
+			}
+		}
+	}
+
+	paths := doc.Paths.Map()
+	pathNames := make([]string, 0, len(paths))
+
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+
+	sort.Strings(pathNames)
+
+	for _, path := range pathNames {
+		ex.walkPathItem(path, paths[path])
+	}
+
+	if ex.err != nil {
+		return nil, nil, ex.err
+	}
+
+	return ex.result, ex.unions, nil
+}
+
+func (ex *extractor) walkPathItem(path string, item *openapi3.PathItem) {
+	if ex.err != nil {
+		return
+	}
+
+	for _, param := range item.Parameters {
+		ex.walkParameter(operationIDForPath(path), param)
+	}
+
+	for _, op := range item.Operations() {
+		opID := op.OperationID
+		if opID == "" {
+			opID = operationIDForPath(path)
+		}
+
+		for _, param := range op.Parameters {
+			ex.walkParameter(opID, param)
+		}
+
+		if op.RequestBody != nil && op.RequestBody.Value != nil {
+			ex.walkContentByMediaType(fmt.Sprintf("%s_Request", opID), op.RequestBody.Value.Content)
+		}
+
+		responses := op.Responses.Map()
+		statuses := make([]string, 0, len(responses))
+
+		for status := range responses {
+			statuses = append(statuses, status)
+		}
+
+		sort.Strings(statuses)
+
+		for _, status := range statuses {
+			resp := responses[status]
+			if resp.Value == nil {
+				continue
+			}
+
+			ex.walkContentByMediaType(fmt.Sprintf("%s_Response%s", opID, status), resp.Value.Content)
+		}
+	}
+}
+
+// walkContentByMediaType walks every media type in content under prefix,
+// disambiguating the prefix by media type whenever content declares more
+// than one: otherwise two different media types with differently-valued
+// inline enums at the same property path would synthesize the same name,
+// and the generator's by-name dedup would silently drop all but one.
+func (ex *extractor) walkContentByMediaType(prefix string, content openapi3.Content) {
+	mediaTypes := sortedContentTypes(content)
+	multipleMediaTypes := len(mediaTypes) > 1
+
+	for _, mediaType := range mediaTypes {
+		p := prefix
+
+		if multipleMediaTypes {
+			p = fmt.Sprintf("%s_%s", p, naming.ToTSMemberKey(mediaType))
+		}
+
+		ex.walkInline(p, content[mediaType].Schema)
+	}
+}
+
+// sortedContentTypes returns content's media types in a stable, sorted
+// order: Content is a plain Go map, so iterating it directly would make
+// synthesized enum names non-deterministic across runs.
+func sortedContentTypes(content openapi3.Content) []string {
+	mediaTypes := make([]string, 0, len(content))
+
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+
+	sort.Strings(mediaTypes)
+
+	return mediaTypes
+}
+
+func (ex *extractor) walkParameter(opID string, param *openapi3.ParameterRef) {
+	if param == nil || param.Value == nil {
+		return
+	}
+
+	ex.walkInline(fmt.Sprintf("%s_%s", opID, upperFirst(param.Value.Name)), param.Value.Schema)
+}
+
+// walkInline recurses into an inline schema looking for enums, both
+// at the top level and inside object properties, synthesizing a name from
+// prefix when the schema has no component name of its own.
+func (ex *extractor) walkInline(prefix string, ref *openapi3.SchemaRef) {
+	if ex.err != nil || ref == nil || ref.Value == nil {
+		return
+	}
+
+	if ref.Ref != "" {
+		// Named component reached via $ref: already (or will be) picked up
+		// by the components.schemas pass, nothing to synthesize.
+		return
+	}
+
+	if kind, values, ok := enumCandidate(ref.Value); ok {
+		ex.add(prefix, ref.Value, kind, values)
+	}
+
+	if ex.opts.EmitUnions {
+		ex.addUnion(prefix, ref)
+	}
+
+	names := make([]string, 0, len(ref.Value.Properties))
+
+	for name := range ref.Value.Properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		ex.walkInline(fmt.Sprintf("%s_%s", prefix, upperFirst(name)), ref.Value.Properties[name])
+	}
+
+	if ref.Value.Items != nil {
+		ex.walkInline(prefix, ref.Value.Items)
+	}
+}
+
+func (ex *extractor) addNamed(name string, ref *openapi3.SchemaRef) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+
+	kind, values, ok := enumCandidate(ref.Value)
+	if !ok {
+		return
+	}
+
+	ex.add(name, ref.Value, kind, values)
+}
+
+func (ex *extractor) add(name string, schema *openapi3.Schema, kind model.Kind, values []interface{}) {
+	if ex.err != nil {
+		return
+	}
+
+	sig := signature(kind, values)
+
+	if _, ok := ex.bySignature[sig]; ok {
+		return
+	}
+
+	overrides, err := vendorOverrides(schema.Extensions, values)
+	if err != nil {
+		ex.err = fmt.Errorf("schema %q: %w", name, err)
+
+		return
+	}
+
+	ex.bySignature[sig] = name
+
+	desc := strings.TrimSpace(schema.Description)
+	deprecated := schema.Deprecated
+
+	var since string
+	if v, ok := schema.Extensions["x-since"].(string); ok {
+		since = v
+	}
+
+	items := make([]model.EnumItem, 0, len(values))
+	usedKeys := map[string]int{}
+
+	for i, raw := range values {
+		val := fmt.Sprintf("%v", raw)
+
+		var key string
+		if kind == model.KindString {
+			key = naming.ToTSMemberKey(val)
+		} else {
+			// Numeric literals can't themselves be valid TS identifiers,
+			// so fall back to a positional name unless overridden.
+			key = fmt.Sprintf("Value%d", i)
+		}
+
+		label := val
+
+		if overrides != nil {
+			if overrides[i].Name != "" {
+				key = overrides[i].Name
+			}
+
+			if overrides[i].Description != "" {
+				label = overrides[i].Description
+			}
+		}
+
+		key = naming.Dedupe(usedKeys, key)
+
+		items = append(items, model.EnumItem{
+			Value: val,
+			Key:   key,
+			Label: label,
+		})
+	}
+
+	if len(items) == 0 {
+		return
+	}
+
+	ex.result = append(ex.result, model.EnumSchema{
+		Name:        name,
+		Description: desc,
+		Deprecated:  deprecated,
+		Since:       since,
+		Kind:        kind,
+		Items:       items,
+	})
+}
+
+// addUnion recognizes a oneOf/anyOf schema whose members are all single-value
+// string enums (e.g. each `{type: string, enum: ["a"]}`) as a discriminated
+// string-literal union. When the schema also carries a discriminator, it
+// additionally emits a "<name>Kind" enum keyed by the same literals.
+func (ex *extractor) addUnion(name string, ref *openapi3.SchemaRef) {
+	if ex.err != nil || ref == nil || ref.Value == nil {
+		return
+	}
+
+	schema := ref.Value
+
+	members := schema.OneOf
+	if len(members) == 0 {
+		members = schema.AnyOf
+	}
+
+	if len(members) == 0 {
+		return
+	}
+
+	literals := make([]string, 0, len(members))
+
+	for _, m := range members {
+		if m == nil || m.Value == nil || m.Value.Type == nil || !m.Value.Type.Is("string") || len(m.Value.Enum) != 1 {
+			return // not every member is a single-literal string enum; skip
+		}
+
+		literals = append(literals, fmt.Sprintf("%v", m.Value.Enum[0]))
+	}
+
+	ex.unions = append(ex.unions, model.UnionType{
+		Name:        name,
+		Description: strings.TrimSpace(schema.Description),
+		Literals:    literals,
+	})
+
+	if schema.Discriminator == nil || schema.Discriminator.PropertyName == "" {
+		return
+	}
+
+	values := make([]interface{}, len(literals))
+	for i, lit := range literals {
+		values[i] = lit
+	}
+
+	ex.add(name+"Kind", &openapi3.Schema{Description: schema.Description}, model.KindString, values)
+}
+
+// walkNamedProperties recurses into a named component's object properties,
+// synthesizing a "<prefix><Property>" enum for each inline enum property it
+// finds. $ref properties are skipped: they're already covered by the
+// components.schemas pass under their own name.
+func (ex *extractor) walkNamedProperties(prefix string, ref *openapi3.SchemaRef) {
+	if ex.err != nil || ref == nil || ref.Value == nil {
+		return
+	}
+
+	names := make([]string, 0, len(ref.Value.Properties))
+
+	for name := range ref.Value.Properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		propRef := ref.Value.Properties[name]
+
+		if propRef == nil || propRef.Value == nil || propRef.Ref != "" {
+			continue
+		}
+
+		childPrefix := prefix + upperFirst(name)
+
+		if kind, values, ok := enumCandidate(propRef.Value); ok {
+			ex.add(childPrefix, propRef.Value, kind, values)
+		}
+
+		ex.walkNamedProperties(childPrefix, propRef)
+	}
+}
+
+// enumCandidate reports whether schema is an enum (or OpenAPI 3.1 `const`,
+// treated as a single-member enum) of a type this generator can render, and
+// returns its kind and flattened member values.
+func enumCandidate(schema *openapi3.Schema) (model.Kind, []interface{}, bool) {
+	var kind model.Kind
+
+	switch {
+	case schema.Type != nil && schema.Type.Is("string"):
+		kind = model.KindString
+	case schema.Type != nil && schema.Type.Is("integer"):
+		kind = model.KindInteger
+	case schema.Type != nil && schema.Type.Is("number"):
+		kind = model.KindNumber
+	default:
+		return "", nil, false
+	}
+
+	if len(schema.Enum) > 0 {
+		return kind, schema.Enum, true
+	}
+
+	if schema.Const != nil {
+		return kind, []interface{}{schema.Const}, true
+	}
+
+	return "", nil, false
+}
+
+// signature is a structural-equality key: same kind, same values in the
+// same order, describe the same enum regardless of where it's declared.
+func signature(kind model.Kind, values []interface{}) string {
+	parts := make([]string, len(values)+1)
+	parts[0] = string(kind)
+
+	for i, v := range values {
+		parts[i+1] = fmt.Sprintf("%v", v)
+	}
+
+	return strings.Join(parts, "\x00")
+}
+
+func operationIDForPath(path string) string {
+	name := strings.ReplaceAll(path, "/", "_")
+	name = strings.Trim(name, "_")
+	name = strings.NewReplacer("{", "", "}", "").Replace(name)
+
+	if name == "" {
+		return "Root"
+	}
+
+	return name
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,275 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/model"
+)
+
+func TestEnumCandidate_MixedCaseStringEnum(t *testing.T) {
+	schema := &openapi3.Schema{
+		Type: &openapi3.Types{"string"},
+		Enum: []interface{}{"Active", "INACTIVE", "pending-review"},
+	}
+
+	kind, values, ok := enumCandidate(schema)
+	if !ok {
+		t.Fatal("expected a string enum to be a candidate")
+	}
+
+	if kind != model.KindString {
+		t.Errorf("kind = %q, want %q", kind, model.KindString)
+	}
+
+	if len(values) != 3 {
+		t.Fatalf("values = %v, want 3 entries", values)
+	}
+}
+
+func TestEnumCandidate_NegativeIntegerEnum(t *testing.T) {
+	schema := &openapi3.Schema{
+		Type: &openapi3.Types{"integer"},
+		Enum: []interface{}{-2, -1, 0, 1},
+	}
+
+	kind, values, ok := enumCandidate(schema)
+	if !ok {
+		t.Fatal("expected an integer enum to be a candidate")
+	}
+
+	if kind != model.KindInteger {
+		t.Errorf("kind = %q, want %q", kind, model.KindInteger)
+	}
+
+	if len(values) != 4 {
+		t.Fatalf("values = %v, want 4 entries", values)
+	}
+}
+
+func TestEnumCandidate_Const(t *testing.T) {
+	schema := &openapi3.Schema{
+		Type:  &openapi3.Types{"string"},
+		Const: "fixed",
+	}
+
+	kind, values, ok := enumCandidate(schema)
+	if !ok {
+		t.Fatal("expected a const schema to be treated as a single-member enum")
+	}
+
+	if kind != model.KindString {
+		t.Errorf("kind = %q, want %q", kind, model.KindString)
+	}
+
+	if len(values) != 1 || values[0] != "fixed" {
+		t.Errorf("values = %v, want [\"fixed\"]", values)
+	}
+}
+
+func TestAddUnion_WithDiscriminatorAlsoEmitsKindEnum(t *testing.T) {
+	ex := &extractor{bySignature: map[string]string{}}
+
+	literal := func(v string) *openapi3.SchemaRef {
+		return &openapi3.SchemaRef{Value: &openapi3.Schema{
+			Type: &openapi3.Types{"string"},
+			Enum: []interface{}{v},
+		}}
+	}
+
+	schema := &openapi3.SchemaRef{Value: &openapi3.Schema{
+		OneOf: openapi3.SchemaRefs{literal("cat"), literal("dog")},
+		Discriminator: &openapi3.Discriminator{
+			PropertyName: "petType",
+		},
+	}}
+
+	ex.addUnion("Pet", schema)
+
+	if ex.err != nil {
+		t.Fatalf("unexpected error: %v", ex.err)
+	}
+
+	if len(ex.unions) != 1 || len(ex.unions[0].Literals) != 2 {
+		t.Fatalf("unions = %+v, want 1 union with 2 literals", ex.unions)
+	}
+
+	if len(ex.result) != 1 || ex.result[0].Name != "PetKind" {
+		t.Fatalf("result = %+v, want a single PetKind enum", ex.result)
+	}
+}
+
+func TestWalkNamedProperties_SynthesizesParentPropertyEnum(t *testing.T) {
+	ex := &extractor{opts: Options{EmitInlineEnums: true}, bySignature: map[string]string{}}
+
+	ref := &openapi3.SchemaRef{Value: &openapi3.Schema{
+		Properties: openapi3.Schemas{
+			"status": &openapi3.SchemaRef{Value: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Enum: []interface{}{"active", "inactive"},
+			}},
+		},
+	}}
+
+	ex.walkNamedProperties("User", ref)
+
+	if ex.err != nil {
+		t.Fatalf("unexpected error: %v", ex.err)
+	}
+
+	if len(ex.result) != 1 || ex.result[0].Name != "UserStatus" {
+		t.Fatalf("result = %+v, want a single UserStatus enum", ex.result)
+	}
+}
+
+// TestExtractEnums_Golden loads a real OpenAPI document (testdata) covering
+// a mixed-case string enum and a negative-integer enum and checks the exact
+// extracted enum schemas, end to end through Load and ExtractEnums.
+func TestExtractEnums_Golden(t *testing.T) {
+	doc, err := Load("testdata/mixed_case_and_negative.yaml")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	schemas, _, err := ExtractEnums(doc, Options{})
+	if err != nil {
+		t.Fatalf("ExtractEnums: %v", err)
+	}
+
+	byName := map[string]model.EnumSchema{}
+	for _, s := range schemas {
+		byName[s.Name] = s
+	}
+
+	status, ok := byName["WidgetStatus"]
+	if !ok {
+		t.Fatalf("schemas = %+v, want a WidgetStatus schema", schemas)
+	}
+
+	if status.Kind != model.KindString {
+		t.Errorf("WidgetStatus.Kind = %q, want %q", status.Kind, model.KindString)
+	}
+
+	wantStatusItems := []model.EnumItem{
+		{Value: "Active", Key: "Active", Label: "Active"},
+		{Value: "INACTIVE", Key: "Inactive", Label: "INACTIVE"},
+		{Value: "pending-review", Key: "PendingReview", Label: "pending-review"},
+	}
+
+	if len(status.Items) != len(wantStatusItems) {
+		t.Fatalf("WidgetStatus.Items = %+v, want %+v", status.Items, wantStatusItems)
+	}
+
+	for i, item := range status.Items {
+		if item != wantStatusItems[i] {
+			t.Errorf("WidgetStatus.Items[%d] = %+v, want %+v", i, item, wantStatusItems[i])
+		}
+	}
+
+	offset, ok := byName["WidgetOffset"]
+	if !ok {
+		t.Fatalf("schemas = %+v, want a WidgetOffset schema", schemas)
+	}
+
+	if offset.Kind != model.KindInteger {
+		t.Errorf("WidgetOffset.Kind = %q, want %q", offset.Kind, model.KindInteger)
+	}
+
+	wantOffsetItems := []model.EnumItem{
+		{Value: "-2", Key: "Value0", Label: "-2"},
+		{Value: "-1", Key: "Value1", Label: "-1"},
+		{Value: "0", Key: "Value2", Label: "0"},
+		{Value: "1", Key: "Value3", Label: "1"},
+	}
+
+	if len(offset.Items) != len(wantOffsetItems) {
+		t.Fatalf("WidgetOffset.Items = %+v, want %+v", offset.Items, wantOffsetItems)
+	}
+
+	for i, item := range offset.Items {
+		if item != wantOffsetItems[i] {
+			t.Errorf("WidgetOffset.Items[%d] = %+v, want %+v", i, item, wantOffsetItems[i])
+		}
+	}
+}
+
+// TestExtractEnums_DisambiguatesResponseByMediaType guards against a
+// response with more than one content media type silently losing all but
+// one media type's inline enums: the generator's by-name dedup (see
+// internal/generator) would otherwise keep only the first one synthesized.
+func TestExtractEnums_DisambiguatesResponseByMediaType(t *testing.T) {
+	doc, err := Load("testdata/multi_media_type_response.yaml")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	schemas, _, err := ExtractEnums(doc, Options{})
+	if err != nil {
+		t.Fatalf("ExtractEnums: %v", err)
+	}
+
+	byName := map[string]model.EnumSchema{}
+	for _, s := range schemas {
+		byName[s.Name] = s
+	}
+
+	jsonSchema, ok := byName["getReport_Response200_ApplicationJson_Format"]
+	if !ok {
+		t.Fatalf("schemas = %+v, want a getReport_Response200_ApplicationJson_Format schema", schemas)
+	}
+
+	xmlSchema, ok := byName["getReport_Response200_ApplicationXml_Format"]
+	if !ok {
+		t.Fatalf("schemas = %+v, want a getReport_Response200_ApplicationXml_Format schema", schemas)
+	}
+
+	wantJSONValues := []string{"compact", "verbose"}
+	wantXMLValues := []string{"tag-minimal", "tag-full"}
+
+	for i, item := range jsonSchema.Items {
+		if item.Value != wantJSONValues[i] {
+			t.Errorf("json Items[%d].Value = %q, want %q", i, item.Value, wantJSONValues[i])
+		}
+	}
+
+	for i, item := range xmlSchema.Items {
+		if item.Value != wantXMLValues[i] {
+			t.Errorf("xml Items[%d].Value = %q, want %q", i, item.Value, wantXMLValues[i])
+		}
+	}
+}
+
+func TestAdd_NegativeIntegerKeysFallBackToPositional(t *testing.T) {
+	ex := &extractor{bySignature: map[string]string{}}
+
+	schema := &openapi3.Schema{
+		Type: &openapi3.Types{"integer"},
+		Enum: []interface{}{-2, -1, 0},
+	}
+
+	ex.add("Offset", schema, model.KindInteger, schema.Enum)
+
+	if ex.err != nil {
+		t.Fatalf("unexpected error: %v", ex.err)
+	}
+
+	if len(ex.result) != 1 {
+		t.Fatalf("result = %v, want 1 schema", ex.result)
+	}
+
+	got := ex.result[0]
+
+	wantKeys := []string{"Value0", "Value1", "Value2"}
+	wantValues := []string{"-2", "-1", "0"}
+
+	for i, item := range got.Items {
+		if item.Key != wantKeys[i] {
+			t.Errorf("Items[%d].Key = %q, want %q", i, item.Key, wantKeys[i])
+		}
+
+		if item.Value != wantValues[i] {
+			t.Errorf("Items[%d].Value = %q, want %q", i, item.Value, wantValues[i])
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package openapi
+
+import (
+	"fmt"
+)
+
+// enumValue is the per-member override parsed from either the
+// x-enum-varnames/x-enum-descriptions pair or the x-enum-values object form.
+type enumValue struct {
+	Name        string
+	Description string
+}
+
+// vendorOverrides resolves the de-facto x-enum-varnames/x-enum-descriptions
+// and x-enum-values vendor extensions (emitted by openapi-generator, NSwag
+// and Speakeasy) into a per-member override, keyed by enum index. It returns
+// a nil slice when the schema carries none of these extensions.
+func vendorOverrides(ext map[string]interface{}, enumValues []interface{}) ([]enumValue, error) {
+	if raw, ok := ext["x-enum-values"]; ok {
+		return parseEnumValuesExt(raw, enumValues)
+	}
+
+	varnames, err := stringArrayExt(ext, "x-enum-varnames", len(enumValues))
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions, err := stringArrayExt(ext, "x-enum-descriptions", len(enumValues))
+	if err != nil {
+		return nil, err
+	}
+
+	if varnames == nil && descriptions == nil {
+		return nil, nil
+	}
+
+	overrides := make([]enumValue, len(enumValues))
+
+	for i := range enumValues {
+		if varnames != nil {
+			overrides[i].Name = varnames[i]
+		}
+
+		if descriptions != nil {
+			overrides[i].Description = descriptions[i]
+		}
+	}
+
+	return overrides, nil
+}
+
+// stringArrayExt reads a JSON-array-of-strings vendor extension, returning
+// nil if it is absent and an error if it is present but not a string array
+// of the expected length.
+func stringArrayExt(ext map[string]interface{}, key string, want int) ([]string, error) {
+	raw, ok := ext[key]
+	if !ok {
+		return nil, nil
+	}
+
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of strings", key)
+	}
+
+	if len(items) != want {
+		return nil, fmt.Errorf("%s has %d entries, want %d (one per enum value)", key, len(items), want)
+	}
+
+	out := make([]string, len(items))
+
+	for i, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be a string", key, i)
+		}
+
+		out[i] = s
+	}
+
+	return out, nil
+}
+
+// parseEnumValuesExt parses the object-array form of x-enum-values:
+// [{name, value, description}], matching each entry back to its enum member
+// by value.
+func parseEnumValuesExt(raw interface{}, enumValues []interface{}) ([]enumValue, error) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("x-enum-values must be an array of objects")
+	}
+
+	if len(items) != len(enumValues) {
+		return nil, fmt.Errorf("x-enum-values has %d entries, want %d (one per enum value)", len(items), len(enumValues))
+	}
+
+	byValue := make(map[string]enumValue, len(items))
+
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("x-enum-values[%d] must be an object", i)
+		}
+
+		val, ok := obj["value"]
+		if !ok {
+			return nil, fmt.Errorf("x-enum-values[%d] is missing required field \"value\"", i)
+		}
+
+		ov := enumValue{}
+
+		if name, ok := obj["name"].(string); ok {
+			ov.Name = name
+		}
+
+		if desc, ok := obj["description"].(string); ok {
+			ov.Description = desc
+		}
+
+		byValue[fmt.Sprintf("%v", val)] = ov
+	}
+
+	out := make([]enumValue, len(enumValues))
+
+	for i, v := range enumValues {
+		ov, ok := byValue[fmt.Sprintf("%v", v)]
+		if !ok {
+			return nil, fmt.Errorf("x-enum-values has no entry for enum value %v", v)
+		}
+
+		out[i] = ov
+	}
+
+	return out, nil
+}
@@ -0,0 +1,197 @@
+package openapi
+
+import "testing"
+
+func TestVendorOverrides_NoExtensions(t *testing.T) {
+	overrides, err := vendorOverrides(map[string]interface{}{}, []interface{}{"a", "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if overrides != nil {
+		t.Errorf("overrides = %+v, want nil", overrides)
+	}
+}
+
+func TestVendorOverrides_VarnamesAndDescriptions(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-varnames":     []interface{}{"Active", "Inactive"},
+		"x-enum-descriptions": []interface{}{"Is active", "Is inactive"},
+	}
+
+	overrides, err := vendorOverrides(ext, []interface{}{"a", "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []enumValue{
+		{Name: "Active", Description: "Is active"},
+		{Name: "Inactive", Description: "Is inactive"},
+	}
+
+	if len(overrides) != len(want) {
+		t.Fatalf("overrides = %+v, want %+v", overrides, want)
+	}
+
+	for i, ov := range overrides {
+		if ov != want[i] {
+			t.Errorf("overrides[%d] = %+v, want %+v", i, ov, want[i])
+		}
+	}
+}
+
+func TestVendorOverrides_VarnamesOnly(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-varnames": []interface{}{"Active", "Inactive"},
+	}
+
+	overrides, err := vendorOverrides(ext, []interface{}{"a", "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(overrides) != 2 || overrides[0].Name != "Active" || overrides[0].Description != "" {
+		t.Errorf("overrides = %+v, want varnames set and descriptions empty", overrides)
+	}
+}
+
+func TestVendorOverrides_LengthMismatch(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-varnames": []interface{}{"Active"},
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a", "b"})
+	if err == nil {
+		t.Fatal("expected an error for a length mismatch")
+	}
+}
+
+func TestVendorOverrides_NonStringEntry(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-varnames": []interface{}{"Active", 2},
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a", "b"})
+	if err == nil {
+		t.Fatal("expected an error for a non-string entry")
+	}
+}
+
+func TestVendorOverrides_NotAnArray(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-varnames": "Active",
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a"})
+	if err == nil {
+		t.Fatal("expected an error when the extension isn't an array")
+	}
+}
+
+func TestVendorOverrides_EnumValuesObjectForm(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-values": []interface{}{
+			map[string]interface{}{"value": "b", "name": "B", "description": "is b"},
+			map[string]interface{}{"value": "a", "name": "A", "description": "is a"},
+		},
+	}
+
+	overrides, err := vendorOverrides(ext, []interface{}{"a", "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []enumValue{
+		{Name: "A", Description: "is a"},
+		{Name: "B", Description: "is b"},
+	}
+
+	if len(overrides) != len(want) {
+		t.Fatalf("overrides = %+v, want %+v", overrides, want)
+	}
+
+	for i, ov := range overrides {
+		if ov != want[i] {
+			t.Errorf("overrides[%d] = %+v, want %+v (x-enum-values is matched back by value, not position)", i, ov, want[i])
+		}
+	}
+}
+
+func TestVendorOverrides_EnumValuesTakesPrecedenceOverVarnames(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-values": []interface{}{
+			map[string]interface{}{"value": "a", "name": "FromValues"},
+		},
+		"x-enum-varnames": []interface{}{"FromVarnames"},
+	}
+
+	overrides, err := vendorOverrides(ext, []interface{}{"a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(overrides) != 1 || overrides[0].Name != "FromValues" {
+		t.Errorf("overrides = %+v, want x-enum-values to win", overrides)
+	}
+}
+
+func TestVendorOverrides_EnumValuesLengthMismatch(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-values": []interface{}{
+			map[string]interface{}{"value": "a", "name": "A"},
+		},
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a", "b"})
+	if err == nil {
+		t.Fatal("expected an error for a length mismatch")
+	}
+}
+
+func TestVendorOverrides_EnumValuesNotAnArray(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-values": "nope",
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a"})
+	if err == nil {
+		t.Fatal("expected an error when x-enum-values isn't an array")
+	}
+}
+
+func TestVendorOverrides_EnumValuesEntryNotAnObject(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-values": []interface{}{"not-an-object"},
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a"})
+	if err == nil {
+		t.Fatal("expected an error when an x-enum-values entry isn't an object")
+	}
+}
+
+func TestVendorOverrides_EnumValuesMissingValueField(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-values": []interface{}{
+			map[string]interface{}{"name": "A"},
+		},
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a"})
+	if err == nil {
+		t.Fatal("expected an error when an x-enum-values entry is missing \"value\"")
+	}
+}
+
+func TestVendorOverrides_EnumValuesUnmatchedValue(t *testing.T) {
+	ext := map[string]interface{}{
+		"x-enum-values": []interface{}{
+			map[string]interface{}{"value": "c", "name": "C"},
+		},
+	}
+
+	_, err := vendorOverrides(ext, []interface{}{"a"})
+	if err == nil {
+		t.Fatal("expected an error when x-enum-values has no entry for an enum value")
+	}
+}
@@ -0,0 +1,138 @@
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/model"
+)
+
+var wordRE = regexp.MustCompile(`[A-Za-z0-9]+`)
+
+// FuncMap returns the helpers every built-in template (and any user-supplied
+// one) can call. quoteOpt controls the string-literal quote style used by
+// the quote helper: "single" or "double".
+func FuncMap(quoteOpt string) template.FuncMap {
+	quote := func(s string) string {
+		if quoteOpt == "single" {
+			s = strings.ReplaceAll(s, `\`, `\\`)
+			s = strings.ReplaceAll(s, `'`, `\'`)
+
+			return "'" + s + "'"
+		}
+
+		return strconv.Quote(s)
+	}
+
+	return template.FuncMap{
+		"computedKey": func(schemaName, itemKey string) string {
+			return fmt.Sprintf("[%s.%s]", schemaName, itemKey)
+		},
+		"jsDocLines": jsDocLines,
+		"jsDocTitle": jsDocTitle,
+		"quote":      quote,
+		// literalValue renders a schema's member as the literal to assign:
+		// quoted for string enums, bare for numeric ones, since those are
+		// already valid unquoted TS/JSON number literals.
+		"literalValue": func(schemaKind model.Kind, item model.EnumItem) string {
+			if schemaKind == model.KindInteger || schemaKind == model.KindNumber {
+				return item.Value
+			}
+
+			return quote(item.Value)
+		},
+		"camelCase":      camelCase,
+		"pascalCase":     pascalCase,
+		"snakeCase":      snakeCase,
+		"screamingSnake": func(s string) string { return strings.ToUpper(snakeCase(s)) },
+		"indent":         indent,
+		"hasPrefix":      strings.HasPrefix,
+		"hasSuffix":      strings.HasSuffix,
+	}
+}
+
+func jsDocLines(s string) []string {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+
+	for _, l := range lines {
+		l = strings.TrimSpace(l)
+
+		if l != "" {
+			out = append(out, l)
+		}
+	}
+
+	return out
+}
+
+func jsDocTitle(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+
+	lines := strings.Split(s, "\n")
+
+	return strings.TrimSpace(lines[0])
+}
+
+func words(s string) []string {
+	return wordRE.FindAllString(s, -1)
+}
+
+func pascalCase(s string) string {
+	parts := words(s)
+
+	for i, p := range parts {
+		parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+	}
+
+	return strings.Join(parts, "")
+}
+
+func camelCase(s string) string {
+	p := pascalCase(s)
+
+	if p == "" {
+		return p
+	}
+
+	return strings.ToLower(p[:1]) + p[1:]
+}
+
+func snakeCase(s string) string {
+	parts := words(s)
+
+	for i, p := range parts {
+		parts[i] = strings.ToLower(p)
+	}
+
+	return strings.Join(parts, "_")
+}
+
+// indent prefixes every non-empty line of s with n spaces, for templates
+// that need to nest generated blocks inside another construct.
+func indent(n int, s string) string {
+	prefix := strings.Repeat(" ", n)
+	lines := strings.Split(s, "\n")
+
+	for i, l := range lines {
+		if l == "" {
+			continue
+		}
+
+		lines[i] = prefix + l
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,31 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/model"
+)
+
+func TestLiteralValue(t *testing.T) {
+	cases := []struct {
+		name string
+		kind model.Kind
+		item model.EnumItem
+		want string
+	}{
+		{"string is quoted", model.KindString, model.EnumItem{Value: "pending-review"}, `"pending-review"`},
+		{"integer is bare", model.KindInteger, model.EnumItem{Value: "-2"}, "-2"},
+		{"number is bare", model.KindNumber, model.EnumItem{Value: "1.5"}, "1.5"},
+	}
+
+	funcs := FuncMap("double")
+	literalValue := funcs["literalValue"].(func(model.Kind, model.EnumItem) string)
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := literalValue(c.kind, c.item); got != c.want {
+				t.Errorf("literalValue(%q, %+v) = %q, want %q", c.kind, c.item, got, c.want)
+			}
+		})
+	}
+}
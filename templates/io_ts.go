@@ -0,0 +1,16 @@
+package templates
+
+// IoTsTemplate renders each schema as an io-ts literal union codec.
+const IoTsTemplate = `// Code generated by openapi-ts-enumgen from {{.SourceBase}}. DO NOT EDIT.
+import * as t from "io-ts";
+{{range $s := .Schemas}}
+{{if $s.Description}}/**
+ * {{jsDocTitle $s.Description}}
+{{range jsDocLines $s.Description}} * {{.}}
+{{end}} */
+{{end}}export const {{$s.Name}} = t.union([
+{{range $s.Items}}  t.literal({{literalValue $s.Kind .}}),
+{{end}}]);
+
+export type {{$s.Name}} = t.TypeOf<typeof {{$s.Name}}>;
+{{end}}`
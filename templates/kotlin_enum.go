@@ -0,0 +1,15 @@
+package templates
+
+// KotlinEnumTemplate renders each schema as a Kotlin enum class carrying
+// its raw wire value.
+const KotlinEnumTemplate = `// Code generated by openapi-ts-enumgen from {{.SourceBase}}. DO NOT EDIT.
+{{range $s := .Schemas}}
+{{if $s.Description}}/**
+ * {{jsDocTitle $s.Description}}
+{{range jsDocLines $s.Description}} * {{.}}
+{{end}} */
+{{end}}enum class {{$s.Name}}(val value: {{if eq $s.Kind "string"}}String{{else if eq $s.Kind "integer"}}Int{{else}}Double{{end}}) {
+{{range $s.Items}}    {{.Key}}({{literalValue $s.Kind .}}),
+{{end}};
+}
+{{end}}`
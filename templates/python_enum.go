@@ -0,0 +1,11 @@
+package templates
+
+// PythonEnumTemplate renders each schema as a stdlib `enum.Enum` class.
+const PythonEnumTemplate = `# Code generated by openapi-ts-enumgen from {{.SourceBase}}. DO NOT EDIT.
+from enum import Enum
+{{range $s := .Schemas}}
+
+class {{$s.Name}}({{if eq $s.Kind "string"}}str{{else if eq $s.Kind "integer"}}int{{else}}float{{end}}, Enum):
+{{if $s.Description}}    """{{jsDocTitle $s.Description}}"""
+{{end}}{{range $s.Items}}    {{.Key}} = {{literalValue $s.Kind .}}
+{{end}}{{end}}`
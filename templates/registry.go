@@ -0,0 +1,49 @@
+package templates
+
+import (
+	"fmt"
+	"os"
+)
+
+// Registry resolves a target's chosen format to template source: one of the
+// built-in formats, or a user-supplied file loaded with Load.
+type Registry struct {
+	builtins map[string]string
+}
+
+// NewRegistry returns a Registry seeded with every built-in format.
+func NewRegistry() *Registry {
+	return &Registry{
+		builtins: map[string]string{
+			"ts-enum":         EnumsTSTemplate,
+			"ts-const-object": TSConstObjectTemplate,
+			"zod-enum":        ZodEnumTemplate,
+			"io-ts":           IoTsTemplate,
+			"python-enum":     PythonEnumTemplate,
+			"kotlin-enum":     KotlinEnumTemplate,
+		},
+	}
+}
+
+// Lookup returns the built-in template source for format, if any.
+func (r *Registry) Lookup(format string) (string, bool) {
+	src, ok := r.builtins[format]
+
+	return src, ok
+}
+
+// Load reads a user-supplied template file from disk.
+func (r *Registry) Load(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read template %s: %w", path, err)
+	}
+
+	return string(b), nil
+}
+
+// Register adds or overrides a named built-in, for callers embedding this
+// package that want to seed their own formats.
+func (r *Registry) Register(format, src string) {
+	r.builtins[format] = src
+}
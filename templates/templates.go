@@ -0,0 +1,32 @@
+// Package templates holds the text/template sources used to render the
+// extracted enum model into generated source files.
+package templates
+
+// EnumsTSTemplate renders the template data (SourceBase, Schemas, Unions)
+// into a single TypeScript source file containing a `type` alias per
+// recognized oneOf/anyOf string-literal union, followed by one `export enum`
+// per schema plus a companion `*Labels` lookup object built from each
+// member's JSDoc label.
+const EnumsTSTemplate = `// Code generated by openapi-ts-enumgen from {{.SourceBase}}. DO NOT EDIT.
+{{range $u := .Unions}}
+{{if $u.Description}}/**
+ * {{jsDocTitle $u.Description}}
+{{range jsDocLines $u.Description}} * {{.}}
+{{end}} */
+{{end}}export type {{$u.Name}} = {{range $i, $l := $u.Literals}}{{if $i}} | {{end}}{{quote $l}}{{end}};
+{{end}}{{range $s := .Schemas}}
+{{if $s.Description}}/**
+ * {{jsDocTitle $s.Description}}
+{{range jsDocLines $s.Description}} * {{.}}
+{{end}}{{if $s.Since}} * @since {{$s.Since}}
+{{end}}{{if $s.Deprecated}} * @deprecated
+{{end}} * @see {{$s.See}}
+ */
+{{end}}export enum {{$s.Name}} {
+{{range $s.Items}}  {{.Key}} = {{literalValue $s.Kind .}},
+{{end}}}
+
+export const {{$s.Name}}Labels: Record<{{$s.Name}}, string> = {
+{{range $s.Items}}  {{computedKey $s.Name .Key}}: {{quote .Label}},
+{{end}}};
+{{end}}`
@@ -0,0 +1,85 @@
+package templates
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/taknb2nch/openapi-ts-enumgen/internal/model"
+)
+
+// integerSchemaData is a single negative-integer enum, the case the
+// non-TS templates previously rendered as a string-typed wire value
+// regardless of Kind.
+var integerSchemaData = struct {
+	SourceBase string
+	Schemas    []model.EnumSchema
+	Unions     []model.UnionType
+}{
+	SourceBase: "spec.yaml",
+	Schemas: []model.EnumSchema{{
+		Name: "Offset",
+		Kind: model.KindInteger,
+		Items: []model.EnumItem{
+			{Value: "-2", Key: "Value0", Label: "-2"},
+			{Value: "-1", Key: "Value1", Label: "-1"},
+		},
+	}},
+}
+
+func render(t *testing.T, src string) string {
+	t.Helper()
+
+	tpl, err := template.New("t").Funcs(FuncMap("double")).Parse(src)
+	if err != nil {
+		t.Fatalf("parse template: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, integerSchemaData); err != nil {
+		t.Fatalf("render template: %v", err)
+	}
+
+	return out.String()
+}
+
+func TestPythonEnumTemplate_IntegerKindUsesIntBase(t *testing.T) {
+	out := render(t, PythonEnumTemplate)
+
+	if !strings.Contains(out, "class Offset(int, Enum):") {
+		t.Errorf("output = %q, want an (int, Enum) base class", out)
+	}
+
+	if strings.Contains(out, `Value0 = "-2"`) {
+		t.Errorf("output = %q, integer member value must not be quoted", out)
+	}
+}
+
+func TestKotlinEnumTemplate_IntegerKindUsesIntCtor(t *testing.T) {
+	out := render(t, KotlinEnumTemplate)
+
+	if !strings.Contains(out, "enum class Offset(val value: Int) {") {
+		t.Errorf("output = %q, want a value: Int constructor", out)
+	}
+
+	if strings.Contains(out, `Value0("-2")`) {
+		t.Errorf("output = %q, integer member value must not be quoted", out)
+	}
+}
+
+func TestZodEnumTemplate_IntegerKindUsesLiteralUnion(t *testing.T) {
+	out := render(t, ZodEnumTemplate)
+
+	if !strings.Contains(out, "z.union([") {
+		t.Errorf("output = %q, want a z.union of z.literal(...) for a numeric kind", out)
+	}
+
+	if strings.Contains(out, "z.enum([") {
+		t.Errorf("output = %q, z.enum only accepts string literals", out)
+	}
+
+	if !strings.Contains(out, "z.literal(-2)") {
+		t.Errorf("output = %q, want an unquoted numeric literal", out)
+	}
+}
@@ -0,0 +1,17 @@
+package templates
+
+// TSConstObjectTemplate renders each schema as a frozen `as const` object
+// plus its derived union type, for callers who prefer structural typing
+// over TypeScript's nominal `enum`.
+const TSConstObjectTemplate = `// Code generated by openapi-ts-enumgen from {{.SourceBase}}. DO NOT EDIT.
+{{range $s := .Schemas}}
+{{if $s.Description}}/**
+ * {{jsDocTitle $s.Description}}
+{{range jsDocLines $s.Description}} * {{.}}
+{{end}} */
+{{end}}export const {{$s.Name}} = {
+{{range $s.Items}}  {{.Key}}: {{literalValue $s.Kind .}},
+{{end}}} as const;
+
+export type {{$s.Name}} = (typeof {{$s.Name}})[keyof typeof {{$s.Name}}];
+{{end}}`
@@ -0,0 +1,21 @@
+package templates
+
+// ZodEnumTemplate renders each schema as a `z.enum([...])` (string kinds) or
+// a `z.union([z.literal(...), ...])` (numeric kinds, since z.enum only
+// accepts string literals) plus its inferred TypeScript type, for callers
+// who validate payloads at runtime with zod.
+const ZodEnumTemplate = `// Code generated by openapi-ts-enumgen from {{.SourceBase}}. DO NOT EDIT.
+import { z } from "zod";
+{{range $s := .Schemas}}
+{{if $s.Description}}/**
+ * {{jsDocTitle $s.Description}}
+{{range jsDocLines $s.Description}} * {{.}}
+{{end}} */
+{{end}}export const {{$s.Name}}Schema = {{if eq $s.Kind "string"}}z.enum([
+{{range $s.Items}}  {{literalValue $s.Kind .}},
+{{end}}]){{else}}z.union([
+{{range $s.Items}}  z.literal({{literalValue $s.Kind .}}),
+{{end}}]){{end}};
+
+export type {{$s.Name}} = z.infer<typeof {{$s.Name}}Schema>;
+{{end}}`